@@ -0,0 +1,80 @@
+package gin
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"strconv"
+)
+
+// AuthUserKey is the key used to store the authenticated user's name into
+// the context, set by BasicAuth/BasicAuthForRealm on a successful login.
+const AuthUserKey = "user"
+
+// Accounts maps a user name to its password, the credential list accepted
+// by BasicAuth/BasicAuthForRealm.
+type Accounts map[string]string
+
+// authPairs maps a precomputed "Basic <base64(user:password)>" header value
+// to the user it belongs to, so each request only has to compare the
+// incoming header against this set instead of re-encoding every account.
+type authPairs map[string]string
+
+func processAccounts(accounts Accounts) authPairs {
+	if len(accounts) == 0 {
+		panic("gin: BasicAuth accounts can not be empty")
+	}
+	pairs := make(authPairs, len(accounts))
+	for user, password := range accounts {
+		pairs[authorizationHeader(user, password)] = user
+	}
+	return pairs
+}
+
+func authorizationHeader(user, password string) string {
+	base := user + ":" + password
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(base))
+}
+
+// searchCredential compares authValue against every known pair using a
+// constant-time comparison, so a request can't learn anything about a
+// partially-correct credential from timing.
+func (pairs authPairs) searchCredential(authValue string) (string, bool) {
+	if len(authValue) == 0 {
+		return "", false
+	}
+	for value, user := range pairs {
+		if subtle.ConstantTimeCompare([]byte(value), []byte(authValue)) == 1 {
+			return user, true
+		}
+	}
+	return "", false
+}
+
+// BasicAuth returns a HandlerFunc requiring HTTP Basic Auth, authorized
+// against the given accounts, using the default realm name.
+func BasicAuth(accounts Accounts) HandlerFunc {
+	return BasicAuthForRealm(accounts, "")
+}
+
+// BasicAuthForRealm returns a HandlerFunc requiring HTTP Basic Auth,
+// authorized against the given accounts, challenging with the given realm.
+// On success the authenticated user name is stored under AuthUserKey,
+// retrievable downstream with c.Get(gin.AuthUserKey).
+func BasicAuthForRealm(accounts Accounts, realm string) HandlerFunc {
+	if realm == "" {
+		realm = "Authorization Required"
+	}
+	realm = "Basic realm=" + strconv.Quote(realm)
+	pairs := processAccounts(accounts)
+
+	return func(c *Context) {
+		user, found := pairs.searchCredential(c.Req.Header.Get("Authorization"))
+		if !found {
+			c.Writer.Header().Set("WWW-Authenticate", realm)
+			c.Fail(401, errors.New("unauthorized"))
+			return
+		}
+		c.Set(AuthUserKey, user)
+	}
+}
@@ -0,0 +1,97 @@
+package binding
+
+import "net/http"
+
+// Content-Type MIME of the most common data formats.
+const (
+	MIMEJSON              = "application/json"
+	MIMEXML               = "application/xml"
+	MIMEXML2              = "text/xml"
+	MIMEYAML              = "application/x-yaml"
+	MIMEPOSTForm          = "application/x-www-form-urlencoded"
+	MIMEMultipartPOSTForm = "multipart/form-data"
+)
+
+// Binding describes the interface each data binder has to implement.
+//
+// Gin provides a default set of binders covering JSON, XML, YAML, the usual
+// form encodings and plain headers. A binder only has to know how to read
+// its own representation out of a *http.Request, validation of the decoded
+// struct is handled separately by the StructValidator.
+type Binding interface {
+	Name() string
+	Bind(*http.Request, interface{}) error
+}
+
+// BindingUri is implemented by binders that read their values from the
+// named route parameters instead of the request body, query string or
+// headers (there is no *http.Request to read those from).
+type BindingUri interface {
+	Name() string
+	BindUri(map[string][]string, interface{}) error
+}
+
+// StructValidator is the minimal interface which needs to be implemented in
+// order for a struct validator to be registered as the Validator used by
+// the binders below.
+//
+// Gin ships with a default implementation backed by go-playground/validator,
+// but it can be replaced or disabled (by setting Validator to nil) for the
+// whole process.
+type StructValidator interface {
+	// ValidateStruct receives any kind of type, but only performs struct
+	// validation when the underlying value is a struct (or a pointer to one).
+	ValidateStruct(interface{}) error
+
+	// Engine returns the underlying validator engine which powers the
+	// StructValidator implementation, letting callers add custom rules.
+	Engine() interface{}
+}
+
+// Validator is the default validator used by Bind* and ShouldBind*. It is a
+// package level variable so it can be replaced with a custom implementation
+// or disabled by assigning it nil.
+var Validator StructValidator = &defaultValidator{}
+
+// The available binding implementations, ready to use with BindWith/ShouldBindWith.
+var (
+	JSON          = jsonBinding{}
+	XML           = xmlBinding{}
+	Form          = formBinding{}
+	Query         = queryBinding{}
+	FormMultipart = multipartFormBinding{}
+	YAML          = yamlBinding{}
+	Uri           = uriBinding{}
+	Header        = headerBinding{}
+)
+
+// Default chooses the most appropriate Binding for the given HTTP method and
+// Content-Type. GET requests always use the Form binding, which for a GET
+// reads only the query string; every other method is dispatched on
+// Content-Type, falling back to the regular form binding.
+func Default(method, contentType string) Binding {
+	if method == http.MethodGet {
+		return Form
+	}
+
+	switch contentType {
+	case MIMEJSON:
+		return JSON
+	case MIMEXML, MIMEXML2:
+		return XML
+	case MIMEYAML:
+		return YAML
+	case MIMEMultipartPOSTForm:
+		return FormMultipart
+	default: // case MIMEPOSTForm:
+		return Form
+	}
+}
+
+// validate runs obj through the configured Validator, if any.
+func validate(obj interface{}) error {
+	if Validator == nil {
+		return nil
+	}
+	return Validator.ValidateStruct(obj)
+}
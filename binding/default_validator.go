@@ -0,0 +1,50 @@
+package binding
+
+import (
+	"reflect"
+	"sync"
+
+	validator "gopkg.in/go-playground/validator.v8"
+)
+
+// defaultValidator is the out-of-the-box StructValidator backed by
+// go-playground/validator. It is lazily initialized so that importing the
+// binding package never pays its setup cost unless validation is used.
+type defaultValidator struct {
+	once     sync.Once
+	validate *validator.Validate
+}
+
+var _ StructValidator = &defaultValidator{}
+
+// ValidateStruct receives any kind of type, but only performs struct
+// validation on structs, or pointers to structs.
+func (v *defaultValidator) ValidateStruct(obj interface{}) error {
+	value := reflect.ValueOf(obj)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	v.lazyinit()
+	if err := v.validate.Struct(obj); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Engine returns the underlying validator.Validate instance so callers can
+// register custom validation functions.
+func (v *defaultValidator) Engine() interface{} {
+	v.lazyinit()
+	return v.validate
+}
+
+func (v *defaultValidator) lazyinit() {
+	v.once.Do(func() {
+		config := &validator.Config{TagName: "binding"}
+		v.validate = validator.New(config)
+	})
+}
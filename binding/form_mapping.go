@@ -0,0 +1,105 @@
+package binding
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+)
+
+// mapForm maps url.Values-shaped data (or anything with the same
+// map[string][]string shape, e.g. http.Header) onto obj using the "form" tag.
+func mapForm(obj interface{}, form map[string][]string) error {
+	return mapFormByTag(obj, form, "form")
+}
+
+// mapFormByTag walks the fields of the struct pointed to by ptr and, for
+// every exported field, looks up the entry in data named by its tag (or by
+// the field name when the field has no such tag) and assigns it, converting
+// basic kinds (and slices of them) as needed. Fields tagged "-" or without a
+// matching entry are left untouched.
+func mapFormByTag(ptr interface{}, data map[string][]string, tag string) error {
+	value := reflect.ValueOf(ptr)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return errors.New("binding: destination must be a pointer to a struct")
+	}
+	structValue := value.Elem()
+	structType := structValue.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		fieldType := structType.Field(i)
+		fieldValue := structValue.Field(i)
+		if !fieldValue.CanSet() {
+			continue
+		}
+
+		name := fieldType.Tag.Get(tag)
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = fieldType.Name
+		}
+
+		inputs, exists := data[name]
+		if !exists {
+			continue
+		}
+		if err := setFieldValue(fieldValue, inputs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setFieldValue(field reflect.Value, inputs []string) error {
+	if field.Kind() == reflect.Slice {
+		elemType := field.Type().Elem()
+		slice := reflect.MakeSlice(field.Type(), len(inputs), len(inputs))
+		for i, input := range inputs {
+			if err := setWithProperType(elemType.Kind(), input, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+	}
+
+	if len(inputs) == 0 {
+		return nil
+	}
+	return setWithProperType(field.Kind(), inputs[0], field)
+}
+
+func setWithProperType(kind reflect.Kind, val string, field reflect.Value) error {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		intVal, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(intVal)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		uintVal, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(uintVal)
+	case reflect.Float32, reflect.Float64:
+		floatVal, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(floatVal)
+	case reflect.Bool:
+		boolVal, err := strconv.ParseBool(val)
+		if err != nil {
+			return err
+		}
+		field.SetBool(boolVal)
+	case reflect.String:
+		field.SetString(val)
+	default:
+		return errors.New("binding: unsupported field kind " + kind.String())
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package binding
+
+import "net/http"
+
+type headerBinding struct{}
+
+func (headerBinding) Name() string {
+	return "header"
+}
+
+func (headerBinding) Bind(req *http.Request, obj interface{}) error {
+	if err := mapFormByTag(obj, map[string][]string(req.Header), "header"); err != nil {
+		return err
+	}
+	return validate(obj)
+}
@@ -0,0 +1,23 @@
+package binding
+
+import "net/http"
+
+// defaultMemory is the amount of request body kept in memory before
+// ParseMultipartForm starts spilling file parts to temporary files.
+const defaultMemory = 32 << 20 // 32 MB
+
+type multipartFormBinding struct{}
+
+func (multipartFormBinding) Name() string {
+	return "multipart/form-data"
+}
+
+func (multipartFormBinding) Bind(req *http.Request, obj interface{}) error {
+	if err := req.ParseMultipartForm(defaultMemory); err != nil {
+		return err
+	}
+	if err := mapForm(obj, req.MultipartForm.Value); err != nil {
+		return err
+	}
+	return validate(obj)
+}
@@ -0,0 +1,16 @@
+package binding
+
+type uriBinding struct{}
+
+func (uriBinding) Name() string {
+	return "uri"
+}
+
+// BindUri maps route parameters (already collected as a map[string][]string
+// by the caller) onto obj using the "uri" tag.
+func (uriBinding) BindUri(m map[string][]string, obj interface{}) error {
+	if err := mapFormByTag(obj, m, "uri"); err != nil {
+		return err
+	}
+	return validate(obj)
+}
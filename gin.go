@@ -1,14 +1,19 @@
 package gin
 
 import (
+	"context"
 	"encoding/json"
 	"encoding/xml"
-	"github.com/julienschmidt/httprouter"
+	"github.com/gin-gonic/gin/binding"
 	"html/template"
 	"log"
 	"math"
+	"net"
 	"net/http"
+	"os"
 	"path"
+	"strconv"
+	"sync"
 )
 
 const (
@@ -34,14 +39,15 @@ type (
 	// Context是gin最终的一部分, 它允许我们在中间件中传递变量
 	// 管理数据的流动, 例如可以渲染一个json相应或验证请求的json形式
 	Context struct {
-		Req      *http.Request
-		Writer   http.ResponseWriter
-		Keys     map[string]interface{}
-		Errors   []ErrorMsg
-		Params   httprouter.Params
-		handlers []HandlerFunc
-		engine   *Engine
-		index    int8
+		writermem responseWriter
+		Req       *http.Request
+		Writer    ResponseWriter
+		Keys      map[string]interface{}
+		Errors    []ErrorMsg
+		Params    Params
+		handlers  []HandlerFunc
+		engine    *Engine
+		index     int8
 	}
 
 	// Used internally to configure router, a RouterGroup is associated with a prefix
@@ -54,13 +60,39 @@ type (
 		engine   *Engine // engine实例
 	}
 
-	// Represents the web framework, it wrappers the blazing fast httprouter multiplexer and a list of global middlewares.
-	// 代表了gin这个web框架, 包装了超快的httprouter和许多全局中间件
+	// Represents the web framework, it owns a radix tree router (one per
+	// HTTP method) and a list of global middlewares.
+	// 代表了gin这个web框架, 拥有一个基数树路由(每个HTTP方法一棵)和许多全局中间件
 	Engine struct {
 		*RouterGroup // 包装router, 拥有RouterGroup的所有方法
-		handlers404   []HandlerFunc // gin 用于handler404的方法, 说实话没啥用
-		router        *httprouter.Router // 包装的httprouter
+		trees         methodTrees   // 按HTTP方法划分的基数树
+		maxParams     uint8         // 单条路由中出现的最多参数个数
+		noRoute       []HandlerFunc // 用户设置的NoRoute处理链
+		noMethod      []HandlerFunc // 用户设置的NoMethod处理链
+		allNoRoute    []HandlerFunc // noRoute与全局中间件组合后的处理链
+		allNoMethod   []HandlerFunc // noMethod与全局中间件组合后的处理链
+		pool          sync.Pool     // 复用Context, 减少每次请求的内存分配
+		srv           *http.Server  // 最近一次Run*启动的http.Server, 供Shutdown使用
 		HTMLTemplates *template.Template // 包装的模板实例
+
+		// RedirectTrailingSlash, if enabled (default), issues a redirect when
+		// the only matching route differs from the request path by a
+		// trailing slash, e.g. GET /foo/ if only /foo is registered.
+		RedirectTrailingSlash bool
+
+		// RedirectFixedPath, if enabled, tries to fix the request path by
+		// case-insensitively matching a registered route when no exact match
+		// is found, and redirects if one is found.
+		RedirectFixedPath bool
+
+		// HandleMethodNotAllowed, if enabled, responds 405 with an Allow
+		// header listing the methods registered for the path, instead of 404,
+		// when the path matches but the method doesn't.
+		HandleMethodNotAllowed bool
+
+		// SecureJSONPrefix is prepended to the body of SecureJSON responses.
+		// Defaults to "while(1);" when left blank.
+		SecureJSONPrefix string
 	}
 )
 
@@ -69,13 +101,25 @@ type (
 // 返回了一个新的空的Engine实例, 没有附加任何的中间件
 // 最基础的配置选项
 func New() *Engine {
-	engine := &Engine{}
+	engine := &Engine{
+		RedirectTrailingSlash:  true,
+		RedirectFixedPath:      false,
+		HandleMethodNotAllowed: false,
+	}
 	engine.RouterGroup = &RouterGroup{nil, "", nil, engine}
-	engine.router = httprouter.New()
-	engine.router.NotFound = engine
+	engine.pool.New = func() interface{} {
+		return engine.allocateContext()
+	}
 	return engine
 }
 
+// allocateContext builds a blank Context ready to be placed in the pool. It
+// pre-sizes Params to the largest number of route parameters seen so far, so
+// that dispatching a request never has to grow the slice.
+func (engine *Engine) allocateContext() *Context {
+	return &Context{engine: engine, Params: make(Params, 0, engine.maxParams)}
+}
+
 // Returns a Engine instance with the Logger and Recovery already attached.
 // 返回了一个新的空的Engine实例, 附加了Logger和Recover的中间件
 func Default() *Engine {
@@ -89,23 +133,13 @@ func (engine *Engine) LoadHTMLTemplates(pattern string) {
 	engine.HTMLTemplates = template.Must(template.ParseGlob(pattern))
 }
 
-// Adds handlers for NotFound. It return a 404 code by default.
-// 添加了NotFound的handler, 它默认返回一个4040的http code
-func (engine *Engine) NotFound404(handlers ...HandlerFunc) {
-	engine.handlers404 = handlers
-}
-
-// handler404中间件, 似乎没有东西来引用这个函数
-func (engine *Engine) handle404(w http.ResponseWriter, req *http.Request) {
-	handlers := engine.combineHandlers(engine.handlers404)
-	c := engine.createContext(w, req, nil, handlers)
-	if engine.handlers404 == nil {
-		http.NotFound(c.Writer, c.Req)
-	} else {
-		c.Writer.WriteHeader(404)
-	}
-
-	c.Next()
+// Use attaches global middlewares to the router. They will be included in
+// the handlers chain for every single request, including NoRoute/NoMethod.
+// 添加全局中间件, 它会被包含在每一个请求的处理链中, 包括NoRoute/NoMethod
+func (engine *Engine) Use(middleware ...HandlerFunc) {
+	engine.RouterGroup.Use(middleware...)
+	engine.rebuild404Handlers()
+	engine.rebuild405Handlers()
 }
 
 // ServeFiles serves files from the given file system root.
@@ -113,49 +147,89 @@ func (engine *Engine) handle404(w http.ResponseWriter, req *http.Request) {
 // path /defined/root/dir/*filepath.
 // For example if root is "/etc" and *filepath is "passwd", the local file
 // "/etc/passwd" would be served.
-// Internally a http.FileServer is used, therefore http.NotFound is used instead
-// of the Router's NotFound handler.
 // To use the operating system's file system implementation,
 // use http.Dir:
 //     router.ServeFiles("/src/*filepath", http.Dir("/var/www"))
 // ServerFiles 提供了来自文件系统的文件服务
 // 路径必须以`/*filepath`结尾, 文件将会在本地起起来
-// todo: not translation finished
-func (engine *Engine) ServeFiles(path string, root http.FileSystem) {
-	engine.router.ServeFiles(path, root)
+func (group *RouterGroup) ServeFiles(relativePath string, root http.FileSystem) {
+	if len(relativePath) < 10 || relativePath[len(relativePath)-10:] != "/*filepath" {
+		panic("path must end with /*filepath in path '" + relativePath + "'")
+	}
+
+	absolutePath := path.Join(group.prefix, relativePath[:len(relativePath)-10])
+	fileServer := http.StripPrefix(absolutePath, http.FileServer(root))
+
+	group.GET(relativePath, func(c *Context) {
+		fileServer.ServeHTTP(c.Writer, c.Req)
+	})
 }
 
 // ServeHTTP makes the router implement the http.Handler interface.
-// ServeHTTP 使 httprouter 实现了http.Handler的接口
-// 实际上是httprouter来处理请求
+// ServeHTTP 使 Engine 实现了http.Handler的接口
 func (engine *Engine) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	engine.router.ServeHTTP(w, req)
+	engine.handleHTTPRequest(w, req)
 }
 
 // 使用http.ListenAndServe的形式将代码运行起来, 同时也代表engine实现了http.Handler的接口
 //type Handler interface {
 //	ServeHTTP(ResponseWriter, *Request)
 //}
-func (engine *Engine) Run(addr string) {
-	http.ListenAndServe(addr, engine)
+func (engine *Engine) Run(addr string) error {
+	return engine.RunWithServer(&http.Server{Addr: addr})
 }
 
-/************************************/
-/********** ROUTES GROUPING *********/
-/************************************/
+// RunTLS attaches the router to a http.Server and starts listening and
+// serving HTTPS (secure) requests.
+func (engine *Engine) RunTLS(addr, certFile, keyFile string) error {
+	srv := &http.Server{Addr: addr, Handler: engine}
+	engine.srv = srv
+	return srv.ListenAndServeTLS(certFile, keyFile)
+}
 
-// createContext 创建context
-func (group *RouterGroup) createContext(w http.ResponseWriter, req *http.Request, params httprouter.Params, handlers []HandlerFunc) *Context {
-	return &Context{
-		Writer:   w,
-		Req:      req,
-		index:    -1,
-		engine:   group.engine,
-		Params:   params,
-		handlers: handlers,
+// RunUnix attaches the router to a http.Server and starts listening and
+// serving HTTP requests through the specified unix socket.
+func (engine *Engine) RunUnix(file string) error {
+	os.Remove(file)
+	listener, err := net.Listen("unix", file)
+	if err != nil {
+		return err
 	}
+	defer listener.Close()
+	return engine.RunListener(listener)
 }
 
+// RunListener attaches the router to a http.Server and starts listening and
+// serving HTTP requests through the specified net.Listener.
+func (engine *Engine) RunListener(listener net.Listener) error {
+	srv := &http.Server{Handler: engine}
+	engine.srv = srv
+	return srv.Serve(listener)
+}
+
+// RunWithServer lets callers configure the underlying http.Server (timeouts,
+// TLSConfig, MaxHeaderBytes, ...) themselves, overriding its Handler with the
+// engine and starting it with ListenAndServe.
+func (engine *Engine) RunWithServer(srv *http.Server) error {
+	srv.Handler = engine
+	engine.srv = srv
+	return srv.ListenAndServe()
+}
+
+// Shutdown gracefully shuts down the http.Server started by the last Run*
+// call, without interrupting any active connections, delegating to
+// http.Server.Shutdown. It is a no-op if the engine hasn't been run yet.
+func (engine *Engine) Shutdown(ctx context.Context) error {
+	if engine.srv == nil {
+		return nil
+	}
+	return engine.srv.Shutdown(ctx)
+}
+
+/************************************/
+/********** ROUTES GROUPING *********/
+/************************************/
+
 // Adds middlewares to the group, see example code in github.
 // 添加中间件到group, 请看示例代码
 func (group *RouterGroup) Use(middlewares ...HandlerFunc) {
@@ -197,9 +271,7 @@ func (group *RouterGroup) Group(component string, handlers ...HandlerFunc) *Rout
 func (group *RouterGroup) Handle(method, p string, handlers []HandlerFunc) {
 	p = path.Join(group.prefix, p)
 	handlers = group.combineHandlers(handlers)
-	group.engine.router.Handle(method, p, func(w http.ResponseWriter, req *http.Request, params httprouter.Params) {
-		group.createContext(w, req, params, handlers).Next()
-	})
+	group.engine.addRoute(method, p, handlers)
 }
 
 // POST is a shortcut for router.Handle("POST", path, handle)
@@ -236,6 +308,24 @@ func (group *RouterGroup) combineHandlers(handlers []HandlerFunc) []HandlerFunc
 	return h
 }
 
+/************************************/
+/************* LIFECYCLE *************/
+/************************************/
+
+// reset prepares a pooled Context for a new request, zeroing everything a
+// previous request might have left behind. It's called by the engine right
+// after pulling a Context out of its sync.Pool.
+func (c *Context) reset(w http.ResponseWriter, req *http.Request, params Params, handlers []HandlerFunc) {
+	c.writermem.reset(w)
+	c.Writer = &c.writermem
+	c.Req = req
+	c.Params = params
+	c.handlers = handlers
+	c.index = -1
+	c.Keys = nil
+	c.Errors = c.Errors[:0]
+}
+
 /************************************/
 /****** FLOW AND ERROR MANAGEMENT****/
 /************************************/
@@ -258,6 +348,7 @@ func (c *Context) Next() {
 // The rest of pending handlers would never be called for that request.
 func (c *Context) Abort(code int) {
 	c.Writer.WriteHeader(code)
+	c.writermem.writeHeaderNow()
 	c.index = AbortIndex
 }
 
@@ -282,6 +373,13 @@ func (c *Context) Error(err error, meta interface{}) {
 	})
 }
 
+// Param returns the value of the URL param, i.e. a route registered at
+// "/user/:id" is matched against "/user/42", Param("id") == "42".
+// It's a shortcut for c.Params.ByName(key).
+func (c *Context) Param(key string) string {
+	return c.Params.ByName(key)
+}
+
 /************************************/
 /******** METADATA MANAGEMENT********/
 /************************************/
@@ -328,68 +426,285 @@ func (c *Context) EnsureBody(item interface{}) bool {
 func (c *Context) ParseBody(item interface{}) error {
 	decoder := json.NewDecoder(c.Req.Body)
 	if err := decoder.Decode(&item); err == nil {
-		return Validate(c, item)
+		if binding.Validator == nil {
+			return nil
+		}
+		return binding.Validator.ValidateStruct(item)
 	} else {
 		return err
 	}
 }
 
+/************************************/
+/************* BINDING **************/
+/************************************/
+
+// ContentType returns the Content-Type header of the request, with any
+// parameters (e.g. "; charset=utf-8") stripped off.
+func (c *Context) ContentType() string {
+	return filterFlags(c.Req.Header.Get("Content-Type"))
+}
+
+func filterFlags(content string) string {
+	for i, char := range content {
+		if char == ' ' || char == ';' {
+			return content[:i]
+		}
+	}
+	return content
+}
+
+// paramsMap turns the route's Params into the map[string][]string
+// shape binding.BindingUri expects.
+func (c *Context) paramsMap() map[string][]string {
+	m := make(map[string][]string, len(c.Params))
+	for _, p := range c.Params {
+		m[p.Key] = []string{p.Value}
+	}
+	return m
+}
+
+// Bind checks the Content-Type to select a binding engine automatically,
+// depending on the "Content-Type" header different bindings are used, for
+// example:
+//     "application/json" --> JSON binding
+//     "application/xml"   --> XML binding
+// It parses the request's body as a JSON/XML/... input. It decodes the
+// payload into the struct specified as a pointer. It records the error and
+// aborts the request with HTTP 400 if input is not valid.
+func (c *Context) Bind(obj interface{}) error {
+	b := binding.Default(c.Req.Method, c.ContentType())
+	return c.MustBindWith(obj, b)
+}
+
+// BindJSON is a shortcut for c.BindWith(obj, binding.JSON).
+func (c *Context) BindJSON(obj interface{}) error {
+	return c.MustBindWith(obj, binding.JSON)
+}
+
+// BindXML is a shortcut for c.BindWith(obj, binding.XML).
+func (c *Context) BindXML(obj interface{}) error {
+	return c.MustBindWith(obj, binding.XML)
+}
+
+// BindYAML is a shortcut for c.BindWith(obj, binding.YAML).
+func (c *Context) BindYAML(obj interface{}) error {
+	return c.MustBindWith(obj, binding.YAML)
+}
+
+// BindQuery is a shortcut for c.BindWith(obj, binding.Query).
+func (c *Context) BindQuery(obj interface{}) error {
+	return c.MustBindWith(obj, binding.Query)
+}
+
+// BindHeader is a shortcut for c.BindWith(obj, binding.Header).
+func (c *Context) BindHeader(obj interface{}) error {
+	return c.MustBindWith(obj, binding.Header)
+}
+
+// BindUri binds the passed struct pointer using binding.Uri, aborting the
+// request with a 400 if the route parameters don't satisfy it.
+func (c *Context) BindUri(obj interface{}) error {
+	if err := binding.Uri.BindUri(c.paramsMap(), obj); err != nil {
+		c.Fail(400, err)
+		return err
+	}
+	return nil
+}
+
+// BindWith binds the passed struct pointer using the specified binding engine.
+func (c *Context) BindWith(obj interface{}, b binding.Binding) error {
+	return c.MustBindWith(obj, b)
+}
+
+// MustBindWith binds the passed struct pointer using the specified binding
+// engine. It records the error and aborts the request with HTTP 400 if any
+// error occurs.
+func (c *Context) MustBindWith(obj interface{}, b binding.Binding) error {
+	if err := c.ShouldBindWith(obj, b); err != nil {
+		c.Fail(400, err)
+		return err
+	}
+	return nil
+}
+
+// ShouldBind checks the Content-Type to select a binding engine automatically,
+// and bind obj with it. Unlike Bind, it does not touch the response if the
+// binding fails, leaving the caller free to decide what to do next.
+func (c *Context) ShouldBind(obj interface{}) error {
+	b := binding.Default(c.Req.Method, c.ContentType())
+	return c.ShouldBindWith(obj, b)
+}
+
+// ShouldBindJSON is a shortcut for c.ShouldBindWith(obj, binding.JSON).
+func (c *Context) ShouldBindJSON(obj interface{}) error {
+	return c.ShouldBindWith(obj, binding.JSON)
+}
+
+// ShouldBindXML is a shortcut for c.ShouldBindWith(obj, binding.XML).
+func (c *Context) ShouldBindXML(obj interface{}) error {
+	return c.ShouldBindWith(obj, binding.XML)
+}
+
+// ShouldBindYAML is a shortcut for c.ShouldBindWith(obj, binding.YAML).
+func (c *Context) ShouldBindYAML(obj interface{}) error {
+	return c.ShouldBindWith(obj, binding.YAML)
+}
+
+// ShouldBindQuery is a shortcut for c.ShouldBindWith(obj, binding.Query).
+func (c *Context) ShouldBindQuery(obj interface{}) error {
+	return c.ShouldBindWith(obj, binding.Query)
+}
+
+// ShouldBindHeader is a shortcut for c.ShouldBindWith(obj, binding.Header).
+func (c *Context) ShouldBindHeader(obj interface{}) error {
+	return c.ShouldBindWith(obj, binding.Header)
+}
+
+// ShouldBindUri binds the passed struct pointer using binding.Uri, without
+// touching the response if the route parameters don't satisfy it.
+func (c *Context) ShouldBindUri(obj interface{}) error {
+	return binding.Uri.BindUri(c.paramsMap(), obj)
+}
+
+// ShouldBindWith binds the passed struct pointer using the specified binding
+// engine, without touching the response if any error occurs.
+func (c *Context) ShouldBindWith(obj interface{}, b binding.Binding) error {
+	return b.Bind(c.Req, obj)
+}
+
+// defaultSecureJSONPrefix is prepended to the body of SecureJSON responses
+// to keep them from being executed as a <script> tag (JSON hijacking).
+const defaultSecureJSONPrefix = "while(1);"
+
+// render is the shared write path for every Context render helper below: it
+// sets Content-Type only if the handler hasn't already set one, writes the
+// status code at most once, and reports any encoding error through Error.
+func (c *Context) render(code int, contentType string, errMeta interface{}, write func() error) {
+	if contentType != "" {
+		header := c.Writer.Header()
+		if header.Get("Content-Type") == "" {
+			header.Set("Content-Type", contentType)
+		}
+	}
+	if code >= 0 {
+		c.Writer.WriteHeader(code)
+	}
+	if err := write(); err != nil {
+		c.Error(err, errMeta)
+		http.Error(c.Writer, err.Error(), http.StatusInternalServerError)
+	}
+}
+
 // Serializes the given struct as a JSON into the response body in a fast and efficient way.
 // It also sets the Content-Type as "application/json"
 // 快速且高效的讲给定的响应体里面将struct序列化成json格式
 // 他也会将content-type改成 application/json
 func (c *Context) JSON(code int, obj interface{}) {
-	if code >= 0 {
-		c.Writer.WriteHeader(code)
-	}
-	c.Writer.Header().Set("Content-Type", "application/json")
-	encoder := json.NewEncoder(c.Writer)
-	if err := encoder.Encode(obj); err != nil {
-		c.Error(err, obj)
-		http.Error(c.Writer, err.Error(), 500)
-	}
+	c.render(code, "application/json", obj, func() error {
+		return json.NewEncoder(c.Writer).Encode(obj)
+	})
 }
 
 // Serializes the given struct as a XML into the response body in a fast and efficient way.
 // It also sets the Content-Type as "application/xml"
 func (c *Context) XML(code int, obj interface{}) {
-	if code >= 0 {
-		c.Writer.WriteHeader(code)
-	}
-	c.Writer.Header().Set("Content-Type", "application/xml")
-	encoder := xml.NewEncoder(c.Writer)
-	if err := encoder.Encode(obj); err != nil {
-		c.Error(err, obj)
-		http.Error(c.Writer, err.Error(), 500)
-	}
+	c.render(code, "application/xml", obj, func() error {
+		return xml.NewEncoder(c.Writer).Encode(obj)
+	})
 }
 
 // Renders the HTTP template specified by his file name.
 // It also update the HTTP code and sets the Content-Type as "text/html".
 // See http://golang.org/doc/articles/wiki/
 func (c *Context) HTML(code int, name string, data interface{}) {
-	if code >= 0 {
-		c.Writer.WriteHeader(code)
-	}
-	c.Writer.Header().Set("Content-Type", "text/html")
-	if err := c.engine.HTMLTemplates.ExecuteTemplate(c.Writer, name, data); err != nil {
-		c.Error(err, map[string]interface{}{
-			"name": name,
-			"data": data,
-		})
-		http.Error(c.Writer, err.Error(), 500)
-	}
+	c.render(code, "text/html", map[string]interface{}{"name": name, "data": data}, func() error {
+		return c.engine.HTMLTemplates.ExecuteTemplate(c.Writer, name, data)
+	})
 }
 
 // Writes the given string into the response body and sets the Content-Type to "text/plain"
 func (c *Context) String(code int, msg string) {
-	c.Writer.Header().Set("Content-Type", "text/plain")
-	c.Writer.WriteHeader(code)
-	c.Writer.Write([]byte(msg))
+	c.render(code, "text/plain", nil, func() error {
+		_, err := c.Writer.Write([]byte(msg))
+		return err
+	})
 }
 
 // Writes some data into the body stream and updates the HTTP code
 func (c *Context) Data(code int, data []byte) {
-	c.Writer.WriteHeader(code)
-	c.Writer.Write(data)
+	c.render(code, "", nil, func() error {
+		_, err := c.Writer.Write(data)
+		return err
+	})
+}
+
+// JSONP serializes obj as JSON and, if the request carries a "callback"
+// query parameter, wraps it as "callback(...);" with Content-Type
+// "application/javascript" so it can be loaded cross-origin via a <script>
+// tag. Without a callback it behaves exactly like JSON.
+func (c *Context) JSONP(code int, obj interface{}) {
+	callback := c.Req.URL.Query().Get("callback")
+	if callback == "" {
+		c.JSON(code, obj)
+		return
+	}
+
+	c.render(code, "application/javascript", obj, func() error {
+		body, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if _, err := c.Writer.Write([]byte(callback + "(")); err != nil {
+			return err
+		}
+		if _, err := c.Writer.Write(body); err != nil {
+			return err
+		}
+		_, err = c.Writer.Write([]byte(");"))
+		return err
+	})
+}
+
+// SecureJSON serializes obj as JSON, prefixed with c.engine.SecureJSONPrefix
+// (defaulting to "while(1);") to defeat JSON hijacking via a <script> tag.
+func (c *Context) SecureJSON(code int, obj interface{}) {
+	prefix := c.engine.SecureJSONPrefix
+	if prefix == "" {
+		prefix = defaultSecureJSONPrefix
+	}
+
+	c.render(code, "application/json", obj, func() error {
+		body, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if _, err := c.Writer.Write([]byte(prefix)); err != nil {
+			return err
+		}
+		_, err = c.Writer.Write(body)
+		return err
+	})
+}
+
+// Redirect issues an HTTP redirect to location using the given status code,
+// which must be a 3xx redirect code or 201 Created.
+func (c *Context) Redirect(code int, location string) {
+	if (code < http.StatusMultipleChoices || code > http.StatusPermanentRedirect) && code != http.StatusCreated {
+		panic("gin: cannot redirect with status code " + strconv.Itoa(code))
+	}
+	http.Redirect(c.Writer, c.Req, location, code)
+}
+
+// File writes the file at filepath into the response, letting http.ServeFile
+// handle range requests, conditional GETs and Content-Type sniffing.
+func (c *Context) File(filepath string) {
+	http.ServeFile(c.Writer, c.Req, filepath)
+}
+
+// FileAttachment is like File, but sets Content-Disposition so the browser
+// downloads the response as filename instead of rendering it inline.
+func (c *Context) FileAttachment(filepath, filename string) {
+	c.Writer.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	http.ServeFile(c.Writer, c.Req, filepath)
 }
@@ -0,0 +1,56 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newBenchEngine wires up a handful of representative routes (static,
+// :param and *catchAll) so the benchmark exercises the same dispatch path
+// real traffic would.
+func newBenchEngine() *Engine {
+	engine := New()
+	engine.GET("/", func(c *Context) {
+		c.String(http.StatusOK, "hello")
+	})
+	engine.GET("/user/:name", func(c *Context) {
+		c.String(http.StatusOK, c.Param("name"))
+	})
+	engine.GET("/static/*filepath", func(c *Context) {
+		c.String(http.StatusOK, c.Param("filepath"))
+	})
+	return engine
+}
+
+// BenchmarkServeHTTP drives a pooled Context through a full request cycle.
+// Run with -benchmem to see that, after the first few iterations prime the
+// sync.Pool, steady-state allocations stay flat instead of growing with b.N.
+func BenchmarkServeHTTP(b *testing.B) {
+	engine := newBenchEngine()
+	req, _ := http.NewRequest(http.MethodGet, "/user/gordon", nil)
+	w := httptest.NewRecorder()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		engine.ServeHTTP(w, req)
+	}
+}
+
+// BenchmarkServeHTTPParallel drives the same engine under concurrent load,
+// requesting the *catchAll route, which is where a pooled Context (instead of one allocation per request)
+// earns its keep.
+func BenchmarkServeHTTPParallel(b *testing.B) {
+	engine := newBenchEngine()
+	req, _ := http.NewRequest(http.MethodGet, "/static/css/site.css", nil)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		w := httptest.NewRecorder()
+		for pb.Next() {
+			engine.ServeHTTP(w, req)
+		}
+	})
+}
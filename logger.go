@@ -0,0 +1,21 @@
+package gin
+
+import (
+	"log"
+	"time"
+)
+
+// Logger returns a HandlerFunc that logs one line per request: its method,
+// path, response status and latency. It relies on the pooled responseWriter
+// to read back the status code the handler chain actually wrote.
+func Logger() HandlerFunc {
+	return func(c *Context) {
+		start := time.Now()
+		path := c.Req.URL.Path
+		method := c.Req.Method
+
+		c.Next()
+
+		log.Printf("[GIN] %3d | %13v | %-7s %s", c.Writer.Status(), time.Since(start), method, path)
+	}
+}
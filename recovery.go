@@ -0,0 +1,22 @@
+package gin
+
+import (
+	"log"
+	"net/http"
+)
+
+// Recovery returns a HandlerFunc that recovers from any panic raised further
+// down the handler chain, logs it, and replies with a 500 instead of
+// crashing the whole server process.
+func Recovery() HandlerFunc {
+	return func(c *Context) {
+		defer func() {
+			if err := recover(); err != nil {
+				log.Printf("[GIN] panic recovered: %v", err)
+				c.Writer.WriteHeader(http.StatusInternalServerError)
+				c.writermem.writeHeaderNow()
+			}
+		}()
+		c.Next()
+	}
+}
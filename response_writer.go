@@ -0,0 +1,75 @@
+package gin
+
+import "net/http"
+
+// noWritten marks a responseWriter that hasn't had its header written yet,
+// distinguishing it from a response that legitimately wrote zero bytes.
+const noWritten = -1
+
+// ResponseWriter extends http.ResponseWriter with a few accessors so
+// middleware (Logger, Recovery, ...) can inspect the response after the
+// handler chain has run.
+type ResponseWriter interface {
+	http.ResponseWriter
+
+	// Status returns the HTTP status code of the response.
+	Status() int
+
+	// Size returns the number of bytes already written into the response body.
+	Size() int
+
+	// Written returns whether the response header has already been written.
+	Written() bool
+}
+
+// responseWriter is the concrete, poolable ResponseWriter implementation
+// used by Context. It's kept as a value inside Context (not a pointer) so
+// that reusing a pooled Context never re-allocates it.
+type responseWriter struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (w *responseWriter) reset(writer http.ResponseWriter) {
+	w.ResponseWriter = writer
+	w.status = http.StatusOK
+	w.size = noWritten
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if code > 0 && code != w.status {
+		w.status = code
+	}
+}
+
+// writeHeaderNow flushes the status code to the underlying writer the first
+// time a handler actually writes something, mirroring the way the standard
+// library lazily sends 200 on the first Write.
+func (w *responseWriter) writeHeaderNow() {
+	if !w.Written() {
+		w.size = 0
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+func (w *responseWriter) Write(data []byte) (n int, err error) {
+	w.writeHeaderNow()
+	n, err = w.ResponseWriter.Write(data)
+	w.size += n
+	return
+}
+
+func (w *responseWriter) Status() int {
+	return w.status
+}
+
+func (w *responseWriter) Size() int {
+	return w.size
+}
+
+func (w *responseWriter) Written() bool {
+	return w.size != noWritten
+}
+
+var _ ResponseWriter = &responseWriter{}
@@ -0,0 +1,216 @@
+package gin
+
+import (
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// methodTree is the root node of the radix tree registered for one HTTP
+// method (GET, POST, ...). Engine keeps one of these per method so that a
+// lookup never has to branch on method inside the tree itself.
+type methodTree struct {
+	method string
+	root   *node
+}
+
+type methodTrees []methodTree
+
+func (trees methodTrees) get(method string) *node {
+	for _, tree := range trees {
+		if tree.method == method {
+			return tree.root
+		}
+	}
+	return nil
+}
+
+// RouteInfo represents a request route's specification which contains
+// method, path, and the name of its handler, as returned by Engine.Routes().
+type RouteInfo struct {
+	Method  string
+	Path    string
+	Handler string
+}
+
+// RoutesInfo defines a list of RouteInfo instances.
+type RoutesInfo []RouteInfo
+
+// addRoute inserts handlers into the radix tree for the given method, path
+// pair, creating the method's tree the first time it is used.
+func (engine *Engine) addRoute(method, path string, handlers []HandlerFunc) {
+	if path[0] != '/' {
+		panic("path must begin with '/'")
+	}
+	if method == "" {
+		panic("HTTP method can not be empty")
+	}
+	if len(handlers) == 0 {
+		panic("there must be at least one handler")
+	}
+
+	root := engine.trees.get(method)
+	if root == nil {
+		root = new(node)
+		engine.trees = append(engine.trees, methodTree{method: method, root: root})
+	}
+	root.addRoute(path, handlers)
+
+	if paramsCount := countParams(path); paramsCount > engine.maxParams {
+		engine.maxParams = paramsCount
+	}
+}
+
+// Routes returns a slice of registered routes, including some useful
+// information such as the path, the HTTP method and the handler name.
+func (engine *Engine) Routes() (routes RoutesInfo) {
+	for _, tree := range engine.trees {
+		routes = iterateTree("", tree.method, routes, tree.root)
+	}
+	return routes
+}
+
+func iterateTree(path, method string, routes RoutesInfo, n *node) RoutesInfo {
+	path += n.path
+	if len(n.handlers) > 0 {
+		routes = append(routes, RouteInfo{
+			Method:  method,
+			Path:    path,
+			Handler: nameOfLastHandler(n.handlers),
+		})
+	}
+	for _, child := range n.children {
+		routes = iterateTree(path, method, routes, child)
+	}
+	return routes
+}
+
+func nameOfLastHandler(handlers []HandlerFunc) string {
+	if len(handlers) == 0 {
+		return ""
+	}
+	return runtime.FuncForPC(reflect.ValueOf(handlers[len(handlers)-1]).Pointer()).Name()
+}
+
+// NoRoute adds handlers for NoRoute, which is invoked when no matching
+// route is found. It replaces the previous handlers404/NotFound404 machinery.
+func (engine *Engine) NoRoute(handlers ...HandlerFunc) {
+	engine.noRoute = handlers
+	engine.rebuild404Handlers()
+}
+
+// NoMethod adds handlers for NoMethod, invoked when a path matches but no
+// route exists for the request's method and HandleMethodNotAllowed is true.
+func (engine *Engine) NoMethod(handlers ...HandlerFunc) {
+	engine.noMethod = handlers
+	engine.rebuild405Handlers()
+}
+
+func (engine *Engine) rebuild404Handlers() {
+	engine.allNoRoute = engine.combineHandlers(engine.noRoute)
+}
+
+func (engine *Engine) rebuild405Handlers() {
+	engine.allNoMethod = engine.combineHandlers(engine.noMethod)
+}
+
+// handleHTTPRequest looks up the tree for the request's method, dispatching
+// to the matching route, a trailing-slash/case-insensitive redirect, or the
+// NoRoute/NoMethod handler chains.
+func (engine *Engine) handleHTTPRequest(w http.ResponseWriter, req *http.Request) {
+	c := engine.pool.Get().(*Context)
+	defer engine.pool.Put(c)
+
+	httpMethod := req.Method
+	reqPath := req.URL.Path
+
+	if root := engine.trees.get(httpMethod); root != nil {
+		value := root.getValue(reqPath, c.Params[0:0])
+		if value.handlers != nil {
+			c.reset(w, req, value.params, value.handlers)
+			c.Next()
+			c.writermem.writeHeaderNow()
+			return
+		}
+
+		if httpMethod != http.MethodConnect && reqPath != "/" {
+			if value.tsr && engine.RedirectTrailingSlash {
+				redirectTrailingSlash(w, req)
+				return
+			}
+			if engine.RedirectFixedPath && redirectFixedPath(w, req, root) {
+				return
+			}
+		}
+	}
+
+	if engine.HandleMethodNotAllowed {
+		for _, tree := range engine.trees {
+			if tree.method == httpMethod {
+				continue
+			}
+			if value := tree.root.getValue(reqPath, nil); value.handlers != nil {
+				if allow := engine.allowed(reqPath, httpMethod); allow != "" {
+					w.Header().Set("Allow", allow)
+				}
+				engine.serveError(c, w, req, http.StatusMethodNotAllowed, engine.allNoMethod)
+				return
+			}
+		}
+	}
+
+	engine.serveError(c, w, req, http.StatusNotFound, engine.allNoRoute)
+}
+
+func (engine *Engine) serveError(c *Context, w http.ResponseWriter, req *http.Request, code int, handlers []HandlerFunc) {
+	if len(handlers) == 0 {
+		http.Error(w, http.StatusText(code), code)
+		return
+	}
+	c.reset(w, req, nil, handlers)
+	c.Writer.WriteHeader(code)
+	c.writermem.writeHeaderNow()
+	c.Next()
+}
+
+func (engine *Engine) allowed(path, reqMethod string) string {
+	allowed := make([]string, 0, len(engine.trees))
+	for _, tree := range engine.trees {
+		if tree.method == reqMethod {
+			continue
+		}
+		if value := tree.root.getValue(path, nil); value.handlers != nil {
+			allowed = append(allowed, tree.method)
+		}
+	}
+	return strings.Join(allowed, ", ")
+}
+
+func redirectTrailingSlash(w http.ResponseWriter, req *http.Request) {
+	p := req.URL.Path
+	code := http.StatusMovedPermanently
+	if req.Method != http.MethodGet {
+		code = http.StatusTemporaryRedirect
+	}
+	if length := len(p); length > 1 && p[length-1] == '/' {
+		req.URL.Path = p[:length-1]
+	} else {
+		req.URL.Path = p + "/"
+	}
+	http.Redirect(w, req, req.URL.String(), code)
+}
+
+func redirectFixedPath(w http.ResponseWriter, req *http.Request, root *node) bool {
+	fixedPath, ok := root.findCaseInsensitivePath(req.URL.Path)
+	if !ok {
+		return false
+	}
+	code := http.StatusMovedPermanently
+	if req.Method != http.MethodGet {
+		code = http.StatusTemporaryRedirect
+	}
+	req.URL.Path = fixedPath
+	http.Redirect(w, req, req.URL.String(), code)
+	return true
+}
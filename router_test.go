@@ -0,0 +1,195 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func performRequest(engine *Engine, method, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, nil)
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestRouterStaticAndParam(t *testing.T) {
+	engine := New()
+	engine.GET("/user/:name", func(c *Context) {
+		c.String(http.StatusOK, c.Param("name"))
+	})
+	engine.GET("/profile/settings", func(c *Context) {
+		c.String(http.StatusOK, "settings")
+	})
+
+	if w := performRequest(engine, http.MethodGet, "/user/gordon"); w.Body.String() != "gordon" {
+		t.Fatalf("got %q, want %q", w.Body.String(), "gordon")
+	}
+	if w := performRequest(engine, http.MethodGet, "/user/list"); w.Body.String() != "list" {
+		t.Fatalf("got %q, want %q", w.Body.String(), "list")
+	}
+	if w := performRequest(engine, http.MethodGet, "/profile/settings"); w.Body.String() != "settings" {
+		t.Fatalf("got %q, want %q", w.Body.String(), "settings")
+	}
+}
+
+func TestRouterCatchAll(t *testing.T) {
+	engine := New()
+	engine.GET("/static/*filepath", func(c *Context) {
+		c.String(http.StatusOK, c.Param("filepath"))
+	})
+
+	w := performRequest(engine, http.MethodGet, "/static/css/site.css")
+	if want := "/css/site.css"; w.Body.String() != want {
+		t.Fatalf("got %q, want %q", w.Body.String(), want)
+	}
+}
+
+func TestRouterStaticSiblings(t *testing.T) {
+	engine := New()
+	for _, p := range []string{"/a", "/abc", "/abd"} {
+		p := p
+		engine.GET(p, func(c *Context) {
+			c.String(http.StatusOK, p)
+		})
+	}
+
+	for _, p := range []string{"/a", "/abc", "/abd"} {
+		if w := performRequest(engine, http.MethodGet, p); w.Body.String() != p {
+			t.Fatalf("path %s: got %q, want %q", p, w.Body.String(), p)
+		}
+	}
+}
+
+func TestRouterNotFound(t *testing.T) {
+	engine := New()
+	engine.GET("/foo", func(c *Context) { c.String(http.StatusOK, "ok") })
+
+	w := performRequest(engine, http.MethodGet, "/bar")
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestRouterMethodNotAllowed(t *testing.T) {
+	engine := New()
+	engine.HandleMethodNotAllowed = true
+	engine.POST("/foo", func(c *Context) { c.String(http.StatusOK, "ok") })
+
+	w := performRequest(engine, http.MethodGet, "/foo")
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+	if allow := w.Header().Get("Allow"); allow != "POST" {
+		t.Fatalf("got Allow %q, want %q", allow, "POST")
+	}
+}
+
+// TestRouterNotFoundWithMiddleware exercises the NoRoute dispatch path with
+// a non-empty handler chain (the case every Default() engine hits, since
+// Use(Recovery(), Logger()) populates allNoRoute) rather than the empty-chain
+// http.Error fallback.
+func TestRouterNotFoundWithMiddleware(t *testing.T) {
+	engine := New()
+	engine.Use(func(c *Context) { c.Next() })
+	engine.GET("/foo", func(c *Context) { c.String(http.StatusOK, "ok") })
+
+	w := performRequest(engine, http.MethodGet, "/bar")
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestRouterMethodNotAllowedWithMiddleware(t *testing.T) {
+	engine := New()
+	engine.Use(func(c *Context) { c.Next() })
+	engine.HandleMethodNotAllowed = true
+	engine.POST("/foo", func(c *Context) { c.String(http.StatusOK, "ok") })
+
+	w := performRequest(engine, http.MethodGet, "/foo")
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusMethodNotAllowed)
+	}
+	if allow := w.Header().Get("Allow"); allow != "POST" {
+		t.Fatalf("got Allow %q, want %q", allow, "POST")
+	}
+}
+
+func TestRouterRedirectTrailingSlash(t *testing.T) {
+	engine := New()
+	engine.GET("/foo", func(c *Context) { c.String(http.StatusOK, "ok") })
+
+	w := performRequest(engine, http.MethodGet, "/foo/")
+	if w.Code != http.StatusMovedPermanently {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusMovedPermanently)
+	}
+	if loc := w.Header().Get("Location"); loc != "/foo" {
+		t.Fatalf("got Location %q, want %q", loc, "/foo")
+	}
+}
+
+func TestRouterRedirectFixedPath(t *testing.T) {
+	engine := New()
+	engine.RedirectFixedPath = true
+	engine.GET("/Foo", func(c *Context) { c.String(http.StatusOK, "ok") })
+
+	w := performRequest(engine, http.MethodGet, "/foo")
+	if w.Code != http.StatusMovedPermanently {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusMovedPermanently)
+	}
+	if loc := w.Header().Get("Location"); loc != "/Foo" {
+		t.Fatalf("got Location %q, want %q", loc, "/Foo")
+	}
+}
+
+// TestContextPoolingDoesNotLeakParams guards against the pooled Context
+// reusing a previous request's Params slice: sequential requests on the
+// same engine must each observe only their own :id.
+func TestContextPoolingDoesNotLeakParams(t *testing.T) {
+	engine := New()
+	engine.GET("/user/:id", func(c *Context) {
+		c.String(http.StatusOK, c.Param("id"))
+	})
+
+	for i := 0; i < 50; i++ {
+		id := strconv.Itoa(i)
+		w := performRequest(engine, http.MethodGet, "/user/"+id)
+		if w.Body.String() != id {
+			t.Fatalf("request %d: got %q, want %q", i, w.Body.String(), id)
+		}
+	}
+}
+
+// TestContextPoolingDoesNotLeakParamsConcurrently is the concurrent version
+// of the above: every in-flight request must see its own Params even though
+// they're all drawing Context instances from the same sync.Pool.
+func TestContextPoolingDoesNotLeakParamsConcurrently(t *testing.T) {
+	engine := New()
+	engine.GET("/user/:id", func(c *Context) {
+		c.String(http.StatusOK, c.Param("id"))
+	})
+
+	var wg sync.WaitGroup
+	const n = 200
+	mismatches := make(chan string, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := strconv.Itoa(i)
+			w := performRequest(engine, http.MethodGet, "/user/"+id)
+			if w.Body.String() != id {
+				mismatches <- "got " + w.Body.String() + " want " + id
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(mismatches)
+
+	for m := range mismatches {
+		t.Error(m)
+	}
+}
@@ -0,0 +1,462 @@
+package gin
+
+import "strings"
+
+// Param is a single URL parameter, consisting of a key and a value, captured
+// from a ":name" or "*name" segment of a registered route.
+type Param struct {
+	Key   string
+	Value string
+}
+
+// Params is an ordered list of route parameters, as bound by a matching
+// route for the current request.
+type Params []Param
+
+// Get returns the value of the first Param whose key matches name, along
+// with a boolean indicating whether a match was found.
+func (ps Params) Get(name string) (string, bool) {
+	for _, p := range ps {
+		if p.Key == name {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+// ByName returns the value of the first Param whose key matches name, or an
+// empty string if no such param exists.
+func (ps Params) ByName(name string) string {
+	value, _ := ps.Get(name)
+	return value
+}
+
+type nodeType uint8
+
+const (
+	static nodeType = iota
+	root
+	param
+	catchAll
+)
+
+// node is one entry of the per-method radix tree. Each node owns the path
+// segment common to all of its children, so a lookup descends the tree one
+// shared prefix at a time instead of comparing whole paths.
+type node struct {
+	path      string
+	wildChild bool
+	nType     nodeType
+	indices   string
+	children  []*node
+	handlers  []HandlerFunc
+	priority  uint32
+}
+
+// countParams reports how many ":" / "*" segments a route path contains, so
+// callers can size a Params slice without reallocating during lookup.
+func countParams(path string) uint8 {
+	var n uint
+	for i := 0; i < len(path); i++ {
+		if path[i] != ':' && path[i] != '*' {
+			continue
+		}
+		n++
+	}
+	if n >= 255 {
+		return 255
+	}
+	return uint8(n)
+}
+
+// incrementChildPrio increments the priority of the given child and
+// reorders siblings by descending priority. Priority is raised as routes are
+// registered, so children with more routes beneath them are checked first
+// on lookup.
+func (n *node) incrementChildPrio(pos int) int {
+	n.children[pos].priority++
+	prio := n.children[pos].priority
+
+	newPos := pos
+	for newPos > 0 && n.children[newPos-1].priority < prio {
+		n.children[newPos-1], n.children[newPos] = n.children[newPos], n.children[newPos-1]
+		newPos--
+	}
+
+	if newPos != pos {
+		n.indices = n.indices[:newPos] + n.indices[pos:pos+1] + n.indices[newPos:pos] + n.indices[pos+1:]
+	}
+
+	return newPos
+}
+
+// addRoute registers handlers for path, splitting existing nodes on their
+// longest common prefix with path so the tree stays a proper radix tree.
+func (n *node) addRoute(path string, handlers []HandlerFunc) {
+	fullPath := path
+	n.priority++
+
+	if len(n.path) == 0 && len(n.children) == 0 {
+		n.insertChild(path, fullPath, handlers)
+		n.nType = root
+		return
+	}
+
+walk:
+	for {
+		i := longestCommonPrefix(path, n.path)
+
+		if i < len(n.path) {
+			child := node{
+				path:      n.path[i:],
+				wildChild: n.wildChild,
+				nType:     static,
+				indices:   n.indices,
+				children:  n.children,
+				handlers:  n.handlers,
+				priority:  n.priority - 1,
+			}
+
+			n.children = []*node{&child}
+			n.indices = string(n.path[i])
+			n.path = path[:i]
+			n.handlers = nil
+			n.wildChild = false
+		}
+
+		if i < len(path) {
+			path = path[i:]
+
+			if n.wildChild {
+				n = n.children[0]
+				n.priority++
+
+				if len(path) >= len(n.path) && n.path == path[:len(n.path)] &&
+					n.nType != catchAll &&
+					(len(n.path) >= len(path) || path[len(n.path)] == '/') {
+					continue walk
+				}
+				panic("path segment conflicts with existing wildcard in '" + fullPath + "'")
+			}
+
+			c := path[0]
+
+			if n.nType == param && c == '/' && len(n.children) == 1 {
+				n = n.children[0]
+				n.priority++
+				continue walk
+			}
+
+			for i, index := range []byte(n.indices) {
+				if c == index {
+					i = n.incrementChildPrio(i)
+					n = n.children[i]
+					continue walk
+				}
+			}
+
+			if c != ':' && c != '*' {
+				n.indices += string(c)
+				child := &node{}
+				n.children = append(n.children, child)
+				n.incrementChildPrio(len(n.indices) - 1)
+				n = child
+			}
+			n.insertChild(path, fullPath, handlers)
+			return
+		}
+
+		if n.handlers != nil {
+			panic("handlers are already registered for path '" + fullPath + "'")
+		}
+		n.handlers = handlers
+		return
+	}
+}
+
+func (n *node) insertChild(path, fullPath string, handlers []HandlerFunc) {
+	for {
+		wildcard, i, valid := findWildcard(path)
+		if i < 0 {
+			break
+		}
+
+		if !valid {
+			panic("only one wildcard per path segment is allowed, has: '" + wildcard + "' in path '" + fullPath + "'")
+		}
+		if len(wildcard) < 2 {
+			panic("wildcards must be named with a non-empty name in path '" + fullPath + "'")
+		}
+		if len(n.children) > 0 {
+			panic("wildcard segment '" + wildcard + "' conflicts with existing children in path '" + fullPath + "'")
+		}
+
+		if wildcard[0] == ':' {
+			if i > 0 {
+				n.path = path[:i]
+				path = path[i:]
+			}
+
+			n.wildChild = true
+			child := &node{nType: param, path: wildcard}
+			n.children = []*node{child}
+			n = child
+			n.priority++
+
+			if len(wildcard) < len(path) {
+				path = path[len(wildcard):]
+				child := &node{priority: 1}
+				n.children = []*node{child}
+				n = child
+				continue
+			}
+
+			n.handlers = handlers
+			return
+		}
+
+		// catch-all, must be at the end of the path
+		if i+len(wildcard) != len(path) {
+			panic("catch-all routes are only allowed at the end of the path in path '" + fullPath + "'")
+		}
+		if len(n.path) > 0 && n.path[len(n.path)-1] == '/' {
+			panic("catch-all conflicts with existing handle for the path segment root in path '" + fullPath + "'")
+		}
+
+		i--
+		if path[i] != '/' {
+			panic("no / before catch-all in path '" + fullPath + "'")
+		}
+
+		n.path = path[:i]
+
+		child := &node{wildChild: true, nType: catchAll}
+		n.children = []*node{child}
+		n.indices = string('/')
+		n = child
+		n.priority++
+
+		child = &node{
+			path:     path[i:],
+			nType:    catchAll,
+			handlers: handlers,
+			priority: 1,
+		}
+		n.children = []*node{child}
+		return
+	}
+
+	n.path = path
+	n.handlers = handlers
+}
+
+// nodeValue is the result of a tree lookup: the handler chain, if any, the
+// params bound along the way, and whether a trailing-slash redirect would
+// have found a match.
+type nodeValue struct {
+	handlers []HandlerFunc
+	params   Params
+	tsr      bool
+}
+
+// getValue walks the tree looking for a handler chain registered for path,
+// collecting ":"/"*" params as it descends.
+func (n *node) getValue(path string, params Params) (value nodeValue) {
+	value.params = params
+walk:
+	for {
+		prefix := n.path
+		if len(path) > len(prefix) {
+			if path[:len(prefix)] == prefix {
+				path = path[len(prefix):]
+
+				if !n.wildChild {
+					c := path[0]
+					for i, index := range []byte(n.indices) {
+						if c == index {
+							n = n.children[i]
+							continue walk
+						}
+					}
+
+					value.tsr = path == "/" && n.handlers != nil
+					return
+				}
+
+				n = n.children[0]
+				switch n.nType {
+				case param:
+					end := 0
+					for end < len(path) && path[end] != '/' {
+						end++
+					}
+
+					value.params = append(value.params, Param{
+						Key:   n.path[1:],
+						Value: path[:end],
+					})
+
+					if end < len(path) {
+						if len(n.children) > 0 {
+							path = path[end:]
+							n = n.children[0]
+							continue walk
+						}
+
+						value.tsr = len(path) == end+1
+						return
+					}
+
+					if value.handlers = n.handlers; value.handlers != nil {
+						return
+					}
+					if len(n.children) == 1 {
+						n = n.children[0]
+						value.tsr = n.path == "/" && n.handlers != nil
+					}
+					return
+
+				case catchAll:
+					value.params = append(value.params, Param{
+						Key:   n.path[2:],
+						Value: path,
+					})
+					value.handlers = n.handlers
+					return
+
+				default:
+					panic("invalid node type")
+				}
+			}
+		} else if path == prefix {
+			if value.handlers = n.handlers; value.handlers != nil {
+				return
+			}
+
+			if path == "/" && n.wildChild && n.nType != root {
+				value.tsr = true
+				return
+			}
+
+			for i, index := range []byte(n.indices) {
+				if index == '/' {
+					n = n.children[i]
+					value.tsr = (len(n.path) == 1 && n.handlers != nil) ||
+						(n.nType == catchAll && n.children[0].handlers != nil)
+					return
+				}
+			}
+
+			return
+		}
+
+		value.tsr = path == "/" ||
+			(len(prefix) == len(path)+1 && prefix[len(path)] == '/' &&
+				path == prefix[:len(prefix)-1] && n.handlers != nil)
+		return
+	}
+}
+
+// findCaseInsensitivePath looks up path ignoring case, returning the
+// canonically-cased path registered in the tree, if any. It backs the
+// RedirectFixedPath behaviour.
+func (n *node) findCaseInsensitivePath(path string) (string, bool) {
+	return n.findCaseInsensitivePathRec(path, "")
+}
+
+func (n *node) findCaseInsensitivePathRec(path, built string) (string, bool) {
+	lowerPath := strings.ToLower(path)
+	lowerNodePath := strings.ToLower(n.path)
+
+	if len(lowerPath) < len(lowerNodePath) || !strings.HasPrefix(lowerPath, lowerNodePath) {
+		return "", false
+	}
+
+	built += n.path
+	path = path[len(n.path):]
+
+	if len(path) == 0 {
+		if n.handlers != nil {
+			return built, true
+		}
+		for i, index := range []byte(n.indices) {
+			if index == '/' {
+				if out, ok := n.children[i].findCaseInsensitivePathRec("/", built); ok {
+					return out, true
+				}
+			}
+		}
+		return "", false
+	}
+
+	if !n.wildChild {
+		c := path[0]
+		lc := lowerPath[len(lowerNodePath)]
+		for i, index := range []byte(n.indices) {
+			if index == c || (index|0x20 == lc) {
+				if out, ok := n.children[i].findCaseInsensitivePathRec(path, built); ok {
+					return out, true
+				}
+			}
+		}
+		return "", false
+	}
+
+	child := n.children[0]
+	switch child.nType {
+	case param:
+		end := 0
+		for end < len(path) && path[end] != '/' {
+			end++
+		}
+		built += path[:end]
+
+		if end < len(path) {
+			if len(child.children) > 0 {
+				return child.children[0].findCaseInsensitivePathRec(path[end:], built)
+			}
+			return "", false
+		}
+		if child.handlers != nil {
+			return built, true
+		}
+		return "", false
+
+	case catchAll:
+		return built + path, true
+	}
+
+	return "", false
+}
+
+func findWildcard(path string) (wildcard string, i int, valid bool) {
+	for start, c := range []byte(path) {
+		if c != ':' && c != '*' {
+			continue
+		}
+
+		valid = true
+		for end, c := range []byte(path[start+1:]) {
+			switch c {
+			case '/':
+				return path[start : start+1+end], start, valid
+			case ':', '*':
+				valid = false
+			}
+		}
+		return path[start:], start, valid
+	}
+	return "", -1, false
+}
+
+func longestCommonPrefix(a, b string) int {
+	i := 0
+	max := len(a)
+	if len(b) < max {
+		max = len(b)
+	}
+	for i < max && a[i] == b[i] {
+		i++
+	}
+	return i
+}